@@ -1,38 +1,24 @@
 package main
 
-import "fmt"
+import (
+	"fmt"
+	"os"
 
-// This principle states that high-level modules should not depend on low-level modules, but rather both should depend on abstractions. This helps to reduce the coupling between components and make the code more flexible and maintainable.
-
-// Notifier interface represents the abstraction for sending notifications
-type Notifier interface {
-	Send(message string) error
-}
-
-// EmailNotifier is a low-level module that implements the Notifier interface
-type EmailNotifier struct{}
-
-func (e EmailNotifier) Send(message string) error {
-	fmt.Println("Sending email with message:", message)
-	return nil
-}
-
-// SMSNotifier is another low-level module that implements the Notifier interface
-type SMSNotifier struct{}
+	"github.com/Riteek712/SOLID-GO/adapter"
+	"github.com/Riteek712/SOLID-GO/di"
+	"github.com/Riteek712/SOLID-GO/notifier"
+)
 
-func (s SMSNotifier) Send(message string) error {
-	fmt.Println("Sending SMS with message:", message)
-	return nil
-}
+// This principle states that high-level modules should not depend on low-level modules, but rather both should depend on abstractions. This helps to reduce the coupling between components and make the code more flexible and maintainable.
 
 // NotificationService is a high-level module that depends on the Notifier abstraction
 type NotificationService struct {
-	notifier Notifier
+	notifier notifier.Notifier
 }
 
 // NewNotificationService creates a new NotificationService with the specified notifier
-func NewNotificationService(notifier Notifier) *NotificationService {
-	return &NotificationService{notifier: notifier}
+func NewNotificationService(n notifier.Notifier) *NotificationService {
+	return &NotificationService{notifier: n}
 }
 
 // Notify sends a notification using the provided Notifier
@@ -45,16 +31,90 @@ func (n *NotificationService) Notify(message string) {
 	}
 }
 
+// fakeTwilioClient stands in for a real Twilio SDK client for this example.
+type fakeTwilioClient struct{}
+
+func (fakeTwilioClient) SendMessage(from, to, body string) error {
+	fmt.Printf("[twilio] %s -> %s: %s\n", from, to, body)
+	return nil
+}
+
+// buildContainer registers each Notifier this example can send through.
+// twilioNotifier is registered Transient, since a real adapter would hold a
+// per-request client/connection rather than being shared across sends.
+func buildContainer() *di.Container {
+	container := di.NewContainer()
+
+	container.Set("emailNotifier", func() (any, error) {
+		return notifier.EmailNotifier{}, nil
+	})
+
+	container.Set("smsNotifier", func() (any, error) {
+		return notifier.SMSNotifier{}, nil
+	})
+
+	container.Set("twilioNotifier", func() (any, error) {
+		return adapter.TwilioAdapter{
+			Client: fakeTwilioClient{},
+			From:   "+10000000000",
+			To:     "+19999999999",
+		}, nil
+	}, di.Transient())
+
+	container.Set("pipelineNotifier", func() (any, error) {
+		twilio, err := container.Get("twilioNotifier")
+		if err != nil {
+			return nil, err
+		}
+		email, err := container.Get("emailNotifier")
+		if err != nil {
+			return nil, err
+		}
+
+		// Composing delivery pipelines declaratively: retry the SMS channel a
+		// few times, then fan the message out to both SMS and email,
+		// best-effort, so one channel failing doesn't block the other.
+		reliableSMS := adapter.RetryNotifier{
+			Notifier:    twilio.(notifier.Notifier),
+			MaxAttempts: 3,
+			BaseDelay:   0, // no delay in the example so it runs instantly
+		}
+		return adapter.CompositeNotifier{
+			Notifiers: []notifier.Notifier{reliableSMS, email.(notifier.Notifier)},
+			FailFast:  false,
+		}, nil
+	})
+
+	return container
+}
+
 func main() {
+	container := buildContainer()
+
 	// High-level module depends on abstractions (Notifier), not concrete implementations (EmailNotifier, SMSNotifier)
-	emailNotifier := EmailNotifier{}
-	smsNotifier := SMSNotifier{}
+	email, err := container.Get("emailNotifier")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Unable to wire dependencies: %v\n", err)
+		os.Exit(1)
+	}
+	NewNotificationService(email.(notifier.Notifier)).Notify("Hello via Email!")
 
-	// Create NotificationService with EmailNotifier
-	notificationServiceEmail := NewNotificationService(emailNotifier)
-	notificationServiceEmail.Notify("Hello via Email!")
+	// Switch to SMSNotifier by simply resolving a different definition
+	sms, err := container.Get("smsNotifier")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Unable to wire dependencies: %v\n", err)
+		os.Exit(1)
+	}
+	NewNotificationService(sms.(notifier.Notifier)).Notify("Hello via SMS!")
 
-	// Switch to SMSNotifier by simply changing the dependency injection
-	notificationServiceSMS := NewNotificationService(smsNotifier)
-	notificationServiceSMS.Notify("Hello via SMS!")
+	// A third-party SDK (here stood in by fakeTwilioClient) doesn't speak
+	// Notifier's Send(message) shape, so it's wrapped in an Adapter; the
+	// retry/composite pipeline built around it is itself resolved from the
+	// container as "pipelineNotifier".
+	pipeline, err := container.Get("pipelineNotifier")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Unable to wire dependencies: %v\n", err)
+		os.Exit(1)
+	}
+	NewNotificationService(pipeline.(notifier.Notifier)).Notify("Hello via Twilio + Email!")
 }
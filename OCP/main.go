@@ -2,67 +2,248 @@ package main
 
 import (
 	"fmt"
+	"sync"
+
+	"github.com/Riteek712/SOLID-GO/di"
 )
 
 // To demonstrate the Open-Closed Principle (OCP) in a practical Go example, let’s consider a scenario where we need to calculate discounts for different types of customers. The Open-Closed Principle states that software entities (classes, modules, functions) should be open for extension but closed for modification.
 
-// In our example, we will create a base interface for discounts and specific implementations for different types of customers. This way, we can add new discount types without changing the existing code, adhering to the OCP.
+// A flat DiscountCalculator per (customer category × promotion) pair breaks
+// down as soon as a category needs more than one promotion: every new
+// combination means a new struct. The Bridge pattern splits "who the
+// customer is" (the Abstraction, CustomerPricing) from "how much they get
+// off" (the Implementor, DiscountRule) so either side can grow independently.
+
+// Code Example: Bridge Pattern applied to the discount example
+// Define the DiscountRule implementor hierarchy.
+// Define the CustomerPricing abstraction hierarchy, each holding a DiscountRule.
+// Add a RuleRegistry so rules can be registered by name and swapped at runtime.
+
+// DiscountRule is the Implementor hierarchy: it knows only how to turn a
+// price into a discounted price.
+type DiscountRule interface {
+	Apply(price float64) float64
+}
+
+// PercentageDiscount discounts a price by a flat percentage.
+type PercentageDiscount struct {
+	Percent float64
+}
+
+func (d PercentageDiscount) Apply(price float64) float64 {
+	return price * (1 - d.Percent/100)
+}
+
+// FlatAmountDiscount subtracts a fixed amount from a price, never going
+// below zero.
+type FlatAmountDiscount struct {
+	Amount float64
+}
+
+func (d FlatAmountDiscount) Apply(price float64) float64 {
+	if discounted := price - d.Amount; discounted > 0 {
+		return discounted
+	}
+	return 0
+}
+
+// CartValueTier is one band of a TieredByCartValue rule.
+type CartValueTier struct {
+	MinValue float64
+	Percent  float64
+}
+
+// TieredByCartValue applies the Percent of the highest tier whose MinValue
+// the price meets or exceeds. Tiers must be sorted ascending by MinValue.
+type TieredByCartValue struct {
+	Tiers []CartValueTier
+}
+
+func (d TieredByCartValue) Apply(price float64) float64 {
+	percent := 0.0
+	for _, tier := range d.Tiers {
+		if price >= tier.MinValue {
+			percent = tier.Percent
+		}
+	}
+	return price * (1 - percent/100)
+}
+
+// SeasonalCampaign applies Percent only while Active reports the campaign is
+// running, otherwise it passes the price through unchanged.
+type SeasonalCampaign struct {
+	Percent float64
+	Active  func() bool
+}
+
+func (d SeasonalCampaign) Apply(price float64) float64 {
+	if d.Active == nil || !d.Active() {
+		return price
+	}
+	return price * (1 - d.Percent/100)
+}
+
+// CustomerPricing is the Abstraction hierarchy: it knows who the customer is
+// and can layer its own pre/post adjustments around whatever DiscountRule it
+// holds, without knowing the rule's concrete type.
+type CustomerPricing interface {
+	FinalPrice(price float64) float64
+}
+
+// BasePricing implements the delegate-to-rule behavior every customer
+// category shares; category types embed it and override FinalPrice only
+// when they need an adjustment of their own.
+type BasePricing struct {
+	Rule DiscountRule
+}
+
+func (p BasePricing) FinalPrice(price float64) float64 {
+	return p.Rule.Apply(price)
+}
 
-// Code Example: Open-Closed Principle
-// Define an interface for Discounts.
-// Implement concrete discount types for various customer categories.
-// Add a function to calculate discounts without modifying it for new customer types.
+// RegularPricing applies its rule with no further adjustment.
+type RegularPricing struct {
+	BasePricing
+}
 
-// DiscountCalculator defines the behavior for calculating discounts
-type DiscountCalculator interface {
-	Calculate(price float64) float64
+// LoyalPricing layers a flat loyalty bonus on top of whatever rule it holds.
+type LoyalPricing struct {
+	BasePricing
+	LoyaltyBonusPercent float64
 }
 
-// RegularCustomerDiscount applies no discount for regular customers
-type RegularCustomerDiscount struct{}
+func (p LoyalPricing) FinalPrice(price float64) float64 {
+	discounted := p.Rule.Apply(price)
+	return discounted * (1 - p.LoyaltyBonusPercent/100)
+}
 
-func (r RegularCustomerDiscount) Calculate(price float64) float64 {
-	return price // No discount applied
+// VIPPricing applies its rule with no further adjustment; VIP status is
+// expected to already be reflected in the rule itself.
+type VIPPricing struct {
+	BasePricing
 }
 
-// LoyalCustomerDiscount applies a 10% discount for loyal customers
-type LoyalCustomerDiscount struct{}
+// NewPricing layers a first-order bonus on top of whatever rule it holds.
+// The bonus is applied on every FinalPrice call; NewPricing does not track
+// whether an order is the customer's first.
+type NewPricing struct {
+	BasePricing
+	FirstOrderBonusPercent float64
+}
 
-func (l LoyalCustomerDiscount) Calculate(price float64) float64 {
-	return price * 0.90 // 10% discount
+func (p NewPricing) FinalPrice(price float64) float64 {
+	discounted := p.Rule.Apply(price)
+	return discounted * (1 - p.FirstOrderBonusPercent/100)
 }
 
-// VIPCustomerDiscount applies a 20% discount for VIP customers
-type VIPCustomerDiscount struct{}
+// RuleRegistry lets DiscountRules be registered by name and swapped at
+// runtime, e.g. from config, instead of being wired into source.
+type RuleRegistry struct {
+	mu    sync.RWMutex
+	rules map[string]DiscountRule
+}
 
-func (v VIPCustomerDiscount) Calculate(price float64) float64 {
-	return price * 0.80 // 20% discount
+// NewRuleRegistry creates an empty RuleRegistry.
+func NewRuleRegistry() *RuleRegistry {
+	return &RuleRegistry{rules: make(map[string]DiscountRule)}
 }
 
-// NewCustomerDiscount applies a 5% discount for new customers
-type NewCustomerDiscount struct{}
+// Register adds or replaces the rule stored under name.
+func (r *RuleRegistry) Register(name string, rule DiscountRule) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.rules[name] = rule
+}
 
-func (n NewCustomerDiscount) Calculate(price float64) float64 {
-	return price * 0.95 // 5% discount
+// Get looks up the rule registered under name.
+func (r *RuleRegistry) Get(name string) (DiscountRule, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	rule, ok := r.rules[name]
+	if !ok {
+		return nil, fmt.Errorf("discount rule %q is not registered", name)
+	}
+	return rule, nil
 }
 
-// CalculateFinalPrice calculates the final price after applying the discount
-func CalculateFinalPrice(price float64, discountCalculator DiscountCalculator) float64 {
-	return discountCalculator.Calculate(price)
+// buildContainer registers one CustomerPricing definition per category. Each
+// factory resolves its DiscountRule from registry rather than main() pulling
+// the rule and assembling the pricing type by hand, so adding a category is
+// a single Set call.
+func buildContainer(registry *RuleRegistry) *di.Container {
+	container := di.NewContainer()
+
+	container.Set("regularPricing", func() (any, error) {
+		rule, err := registry.Get("regular")
+		if err != nil {
+			return nil, err
+		}
+		return RegularPricing{BasePricing{Rule: rule}}, nil
+	})
+
+	container.Set("loyalPricing", func() (any, error) {
+		rule, err := registry.Get("loyal")
+		if err != nil {
+			return nil, err
+		}
+		return LoyalPricing{BasePricing: BasePricing{Rule: rule}, LoyaltyBonusPercent: 2}, nil
+	})
+
+	container.Set("vipPricing", func() (any, error) {
+		rule, err := registry.Get("vip")
+		if err != nil {
+			return nil, err
+		}
+		return VIPPricing{BasePricing{Rule: rule}}, nil
+	})
+
+	container.Set("newCustomerPricing", func() (any, error) {
+		rule, err := registry.Get("new-customer")
+		if err != nil {
+			return nil, err
+		}
+		return NewPricing{BasePricing: BasePricing{Rule: rule}, FirstOrderBonusPercent: 3}, nil
+	})
+
+	return container
 }
 
 func main() {
-	// Test data
 	price := 100.0
 
-	// Calculate prices for different customer types
-	regularDiscount := RegularCustomerDiscount{}
-	loyalDiscount := LoyalCustomerDiscount{}
-	vipDiscount := VIPCustomerDiscount{}
-	newCustomerDiscount := NewCustomerDiscount{}
-
-	fmt.Printf("Regular customer price: $%.2f\n", CalculateFinalPrice(price, regularDiscount))
-	fmt.Printf("Loyal customer price: $%.2f\n", CalculateFinalPrice(price, loyalDiscount))
-	fmt.Printf("VIP customer price: $%.2f\n", CalculateFinalPrice(price, vipDiscount))
-	fmt.Printf("New customer price: $%.2f\n", CalculateFinalPrice(price, newCustomerDiscount))
+	registry := NewRuleRegistry()
+	registry.Register("regular", PercentageDiscount{Percent: 0})
+	registry.Register("loyal", PercentageDiscount{Percent: 10})
+	registry.Register("vip", PercentageDiscount{Percent: 20})
+	registry.Register("new-customer", PercentageDiscount{Percent: 5})
+
+	container := buildContainer(registry)
+
+	regular := container.Must("regularPricing").(CustomerPricing)
+	loyal := container.Must("loyalPricing").(CustomerPricing)
+	vip := container.Must("vipPricing").(CustomerPricing)
+	newCustomer := container.Must("newCustomerPricing").(CustomerPricing)
+
+	fmt.Printf("Regular customer price: $%.2f\n", regular.FinalPrice(price))
+	fmt.Printf("Loyal customer price: $%.2f\n", loyal.FinalPrice(price))
+	fmt.Printf("VIP customer price: $%.2f\n", vip.FinalPrice(price))
+	fmt.Printf("New customer price: $%.2f\n", newCustomer.FinalPrice(price))
+
+	// A "Black Friday" promotion is a new rule, registered at runtime, wired
+	// into a new container definition; no existing CustomerPricing type
+	// needs to change to use it.
+	registry.Register("black-friday", TieredByCartValue{Tiers: []CartValueTier{
+		{MinValue: 0, Percent: 15},
+		{MinValue: 200, Percent: 25},
+	}})
+	container.Set("vipBlackFridayPricing", func() (any, error) {
+		rule, err := registry.Get("black-friday")
+		if err != nil {
+			return nil, err
+		}
+		return VIPPricing{BasePricing{Rule: rule}}, nil
+	})
+	vipBlackFriday := container.Must("vipBlackFridayPricing").(CustomerPricing)
+	fmt.Printf("VIP customer price on Black Friday: $%.2f\n", vipBlackFriday.FinalPrice(price))
 }
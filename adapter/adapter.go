@@ -0,0 +1,50 @@
+package adapter
+
+// TwilioSender is the shape of a third-party Twilio-style SMS client: it
+// knows nothing about notifier.Notifier, only how to send a message between
+// two phone numbers.
+type TwilioSender interface {
+	SendMessage(from, to, body string) error
+}
+
+// TwilioAdapter adapts a TwilioSender to notifier.Notifier.
+type TwilioAdapter struct {
+	Client   TwilioSender
+	From, To string
+}
+
+func (a TwilioAdapter) Send(message string) error {
+	return a.Client.SendMessage(a.From, a.To, message)
+}
+
+// SendGridSender is the shape of a third-party SendGrid-style email client.
+type SendGridSender interface {
+	Deliver(from, to, subject, body string) error
+}
+
+// SendGridAdapter adapts a SendGridSender to notifier.Notifier.
+type SendGridAdapter struct {
+	Client  SendGridSender
+	From    string
+	To      string
+	Subject string
+}
+
+func (a SendGridAdapter) Send(message string) error {
+	return a.Client.Deliver(a.From, a.To, a.Subject, message)
+}
+
+// SlackWebhookPoster is the shape of a third-party Slack webhook client.
+type SlackWebhookPoster interface {
+	PostToWebhook(url string, payload map[string]any) error
+}
+
+// SlackAdapter adapts a SlackWebhookPoster to notifier.Notifier.
+type SlackAdapter struct {
+	Client     SlackWebhookPoster
+	WebhookURL string
+}
+
+func (a SlackAdapter) Send(message string) error {
+	return a.Client.PostToWebhook(a.WebhookURL, map[string]any{"text": message})
+}
@@ -0,0 +1,28 @@
+package adapter
+
+import (
+	"errors"
+
+	"github.com/Riteek712/SOLID-GO/notifier"
+)
+
+// CompositeNotifier fans a single Send call out to every notifier it wraps.
+type CompositeNotifier struct {
+	Notifiers []notifier.Notifier
+	// FailFast stops at the first failing notifier instead of attempting
+	// every notifier and aggregating all the failures.
+	FailFast bool
+}
+
+func (c CompositeNotifier) Send(message string) error {
+	var errs []error
+	for _, n := range c.Notifiers {
+		if err := n.Send(message); err != nil {
+			if c.FailFast {
+				return err
+			}
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,36 @@
+package adapter
+
+import (
+	"time"
+
+	"github.com/Riteek712/SOLID-GO/notifier"
+)
+
+// RetryNotifier decorates a Notifier with exponential backoff: Send is
+// attempted up to MaxAttempts times in total (at least once), doubling
+// BaseDelay between attempts.
+type RetryNotifier struct {
+	Notifier    notifier.Notifier
+	MaxAttempts int
+	BaseDelay   time.Duration
+}
+
+func (r RetryNotifier) Send(message string) error {
+	maxAttempts := r.MaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = 1
+	}
+
+	var err error
+	delay := r.BaseDelay
+	for attempt := 0; attempt < maxAttempts; attempt++ {
+		if err = r.Notifier.Send(message); err == nil {
+			return nil
+		}
+		if attempt < maxAttempts-1 && delay > 0 {
+			time.Sleep(delay)
+		}
+		delay *= 2
+	}
+	return err
+}
@@ -0,0 +1,166 @@
+package di
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// Factory builds one instance of a dependency. It may itself call
+// Container.Get to pull in other definitions, which is how the container
+// replaces hand-written NewXxx(NewYyy(NewZzz())) chains in main().
+type Factory func() (any, error)
+
+// Mode controls how many instances Container.Get produces for a given name.
+type Mode int
+
+const (
+	// Singleton resolves a factory once and caches the result for later Gets.
+	Singleton Mode = iota
+	// transientMode invokes the factory again on every Get call.
+	transientMode
+)
+
+type definition struct {
+	factory Factory
+	mode    Mode
+}
+
+// Option configures a single definition registered via Set.
+type Option func(*definition)
+
+// Transient marks a definition so Get invokes its factory on every call
+// instead of caching a singleton instance.
+func Transient() Option {
+	return func(d *definition) { d.mode = transientMode }
+}
+
+// Container is a minimal, reflection-free-by-default dependency injection
+// container. Definitions are registered by name and resolved lazily; a
+// definition's factory may depend on other definitions by calling Get on the
+// same container, which replaces manual NewXxx(NewYyy(NewZzz())) wiring in
+// main() with a flat list of registrations.
+type Container struct {
+	mu          sync.Mutex
+	definitions map[string]*definition
+	instances   map[string]any
+	resolving   map[string]bool
+}
+
+// NewContainer creates an empty Container.
+func NewContainer() *Container {
+	return &Container{
+		definitions: make(map[string]*definition),
+		instances:   make(map[string]any),
+		resolving:   make(map[string]bool),
+	}
+}
+
+// Set registers factory under name. By default the resulting instance is a
+// singleton; pass Transient() to rebuild it on every Get.
+func (c *Container) Set(name string, factory Factory, opts ...Option) {
+	def := &definition{factory: factory, mode: Singleton}
+	for _, opt := range opts {
+		opt(def)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.definitions[name] = def
+	delete(c.instances, name)
+}
+
+// SetDefinitions registers every factory in defs as a singleton.
+func (c *Container) SetDefinitions(defs map[string]Factory) {
+	for name, factory := range defs {
+		c.Set(name, factory)
+	}
+}
+
+// Get resolves name, invoking its factory at most once per singleton. A
+// definition that is already being resolved higher up the current call stack
+// reports a cycle instead of recursing forever.
+func (c *Container) Get(name string) (any, error) {
+	c.mu.Lock()
+	def, ok := c.definitions[name]
+	if !ok {
+		c.mu.Unlock()
+		return nil, fmt.Errorf("di: no definition registered for %q", name)
+	}
+	if def.mode == Singleton {
+		if instance, ok := c.instances[name]; ok {
+			c.mu.Unlock()
+			return instance, nil
+		}
+	}
+	if c.resolving[name] {
+		c.mu.Unlock()
+		return nil, fmt.Errorf("di: cycle detected while resolving %q", name)
+	}
+	c.resolving[name] = true
+	c.mu.Unlock()
+
+	instance, err := def.factory()
+
+	c.mu.Lock()
+	delete(c.resolving, name)
+	if err == nil && def.mode == Singleton {
+		c.instances[name] = instance
+	}
+	c.mu.Unlock()
+
+	if err != nil {
+		return nil, fmt.Errorf("di: resolving %q: %w", name, err)
+	}
+	return instance, nil
+}
+
+// Must resolves name like Get but panics on error. It is meant for wiring
+// code in main() where a missing or broken definition is a programmer error
+// that should fail fast at startup.
+func (c *Container) Must(name string) any {
+	instance, err := c.Get(name)
+	if err != nil {
+		panic(err)
+	}
+	return instance
+}
+
+// Autowire populates the exported fields of target (a pointer to a struct)
+// tagged `di:"autowire:<name>"`, resolving <name> from the container and
+// assigning it to the field. The named definition must already exist and its
+// resolved instance must be assignable to the field's type; requiring the
+// name up front means Autowire only ever resolves the definitions the target
+// actually asks for, instead of probing every registered definition by type.
+func (c *Container) Autowire(target any) error {
+	v := reflect.ValueOf(target)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("di: Autowire requires a pointer to a struct, got %T", target)
+	}
+	v = v.Elem()
+	t := v.Type()
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag, ok := field.Tag.Lookup("di")
+		if !ok || !field.IsExported() {
+			continue
+		}
+		name, ok := strings.CutPrefix(tag, "autowire:")
+		if !ok || name == "" {
+			return fmt.Errorf(`di: field %q has malformed tag %q, want autowire:<name>`, field.Name, tag)
+		}
+
+		instance, err := c.Get(name)
+		if err != nil {
+			return fmt.Errorf("di: autowiring field %q: %w", field.Name, err)
+		}
+		instanceType := reflect.TypeOf(instance)
+		if instanceType == nil || !instanceType.AssignableTo(field.Type) {
+			return fmt.Errorf("di: autowiring field %q: definition %q resolved to %T, not assignable to %s", field.Name, name, instance, field.Type)
+		}
+		v.Field(i).Set(reflect.ValueOf(instance))
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+package di
+
+import "testing"
+
+func TestContainerGetDetectsCycle(t *testing.T) {
+	c := NewContainer()
+	c.Set("a", func() (any, error) { return c.Get("b") })
+	c.Set("b", func() (any, error) { return c.Get("a") })
+
+	_, err := c.Get("a")
+	if err == nil {
+		t.Fatal("Get on a cyclic definition should fail")
+	}
+}
+
+func TestContainerGetCachesSingleton(t *testing.T) {
+	c := NewContainer()
+	calls := 0
+	c.Set("counter", func() (any, error) {
+		calls++
+		return calls, nil
+	})
+
+	first, err := c.Get("counter")
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	second, err := c.Get("counter")
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if first != second {
+		t.Fatalf("singleton Get returned different instances: %v, %v", first, second)
+	}
+	if calls != 1 {
+		t.Fatalf("factory called %d times, want 1", calls)
+	}
+}
+
+func TestContainerGetTransientRebuildsEveryCall(t *testing.T) {
+	c := NewContainer()
+	calls := 0
+	c.Set("counter", func() (any, error) {
+		calls++
+		return calls, nil
+	}, Transient())
+
+	if _, err := c.Get("counter"); err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if _, err := c.Get("counter"); err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if calls != 2 {
+		t.Fatalf("factory called %d times, want 2", calls)
+	}
+}
+
+func TestAutowireResolvesByName(t *testing.T) {
+	c := NewContainer()
+	c.Set("greeting", func() (any, error) { return "hello", nil })
+
+	var target struct {
+		Greeting string `di:"autowire:greeting"`
+	}
+	if err := c.Autowire(&target); err != nil {
+		t.Fatalf("Autowire: %v", err)
+	}
+	if target.Greeting != "hello" {
+		t.Fatalf("Greeting = %q, want %q", target.Greeting, "hello")
+	}
+}
+
+func TestAutowireRejectsTypeMismatch(t *testing.T) {
+	c := NewContainer()
+	c.Set("greeting", func() (any, error) { return "hello", nil })
+
+	var target struct {
+		Greeting int `di:"autowire:greeting"`
+	}
+	err := c.Autowire(&target)
+	if err == nil {
+		t.Fatal("Autowire should fail when the resolved type doesn't match the field")
+	}
+}
+
+func TestMustPanicsOnError(t *testing.T) {
+	c := NewContainer()
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("Must should panic for an unregistered definition")
+		}
+	}()
+	c.Must("missing")
+}
+
+func TestGetErrorsOnUnregisteredName(t *testing.T) {
+	c := NewContainer()
+	if _, err := c.Get("missing"); err == nil {
+		t.Fatal("Get on an unregistered name should fail")
+	}
+}
@@ -0,0 +1,102 @@
+package endpoint
+
+import (
+	"context"
+
+	"github.com/Riteek712/SOLID-GO/service"
+)
+
+// Endpoint is the go-kit style unit of business logic: it turns a request
+// into a response and knows nothing about the transport (HTTP, gRPC, NATS,
+// ...) it will eventually be exposed over.
+type Endpoint func(ctx context.Context, request any) (response any, err error)
+
+// Middleware wraps an Endpoint to add cross-cutting behavior without
+// changing its signature.
+type Middleware func(Endpoint) Endpoint
+
+// Chain composes middlewares around an endpoint so that Chain(outer, inner)
+// applied to e behaves as outer(inner(e)).
+func Chain(middlewares ...Middleware) Middleware {
+	return func(next Endpoint) Endpoint {
+		for i := len(middlewares) - 1; i >= 0; i-- {
+			next = middlewares[i](next)
+		}
+		return next
+	}
+}
+
+// UserIDRequest is the request for any operation keyed only by user id
+// (GetUser, DeleteUser).
+type UserIDRequest struct {
+	ID int
+}
+
+// UserResponse wraps a single User result.
+type UserResponse struct {
+	User service.User
+}
+
+// CreateUserRequest is the request for MakeCreateUserEndpoint.
+type CreateUserRequest struct {
+	Name  string
+	Email string
+}
+
+// UpdateUserRequest is the request for MakeUpdateUserEndpoint.
+type UpdateUserRequest struct {
+	ID    int
+	Name  string
+	Email string
+}
+
+// DeleteUserResponse signals a DeleteUser call completed with no content to
+// return.
+type DeleteUserResponse struct{}
+
+// MakeCreateUserEndpoint builds the endpoint for UserService.CreateUser.
+func MakeCreateUserEndpoint(svc service.UserService) Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(CreateUserRequest)
+		user, err := svc.CreateUser(ctx, req.Name, req.Email)
+		if err != nil {
+			return nil, err
+		}
+		return UserResponse{User: user}, nil
+	}
+}
+
+// MakeGetUserEndpoint builds the endpoint for UserService.GetUser.
+func MakeGetUserEndpoint(svc service.UserService) Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(UserIDRequest)
+		user, err := svc.GetUser(ctx, req.ID)
+		if err != nil {
+			return nil, err
+		}
+		return UserResponse{User: user}, nil
+	}
+}
+
+// MakeUpdateUserEndpoint builds the endpoint for UserService.UpdateUser.
+func MakeUpdateUserEndpoint(svc service.UserService) Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(UpdateUserRequest)
+		user, err := svc.UpdateUser(ctx, req.ID, req.Name, req.Email)
+		if err != nil {
+			return nil, err
+		}
+		return UserResponse{User: user}, nil
+	}
+}
+
+// MakeDeleteUserEndpoint builds the endpoint for UserService.DeleteUser.
+func MakeDeleteUserEndpoint(svc service.UserService) Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(UserIDRequest)
+		if err := svc.DeleteUser(ctx, req.ID); err != nil {
+			return nil, err
+		}
+		return DeleteUserResponse{}, nil
+	}
+}
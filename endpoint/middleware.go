@@ -0,0 +1,73 @@
+package endpoint
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+)
+
+// LoggingMiddleware logs how long each call to the wrapped Endpoint took and
+// whether it returned an error.
+func LoggingMiddleware(logger *log.Logger) Middleware {
+	return func(next Endpoint) Endpoint {
+		return func(ctx context.Context, request any) (response any, err error) {
+			start := time.Now()
+			defer func() {
+				logger.Printf("took=%s err=%v", time.Since(start), err)
+			}()
+			return next(ctx, request)
+		}
+	}
+}
+
+// Metrics is a tiny Prometheus-style counter/histogram pair, enough to
+// demonstrate InstrumentingMiddleware without pulling in a metrics client.
+type Metrics struct {
+	mu           sync.Mutex
+	requestCount map[string]int
+	latencyMS    map[string][]float64
+}
+
+// NewMetrics creates an empty Metrics collector.
+func NewMetrics() *Metrics {
+	return &Metrics{
+		requestCount: make(map[string]int),
+		latencyMS:    make(map[string][]float64),
+	}
+}
+
+func (m *Metrics) observe(name string, took time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.requestCount[name]++
+	m.latencyMS[name] = append(m.latencyMS[name], float64(took.Microseconds())/1000)
+}
+
+// Count returns how many times the named endpoint has been called.
+func (m *Metrics) Count(name string) int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.requestCount[name]
+}
+
+// LatenciesMS returns the recorded latencies, in milliseconds, for the named
+// endpoint.
+func (m *Metrics) LatenciesMS(name string) []float64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]float64(nil), m.latencyMS[name]...)
+}
+
+// InstrumentingMiddleware records a call counter and a latency histogram for
+// the wrapped Endpoint under name.
+func InstrumentingMiddleware(metrics *Metrics, name string) Middleware {
+	return func(next Endpoint) Endpoint {
+		return func(ctx context.Context, request any) (any, error) {
+			start := time.Now()
+			response, err := next(ctx, request)
+			metrics.observe(name, time.Since(start))
+			return response, err
+		}
+	}
+}
@@ -0,0 +1,24 @@
+package notifier
+
+import "fmt"
+
+// Notifier represents the abstraction for sending notifications.
+type Notifier interface {
+	Send(message string) error
+}
+
+// EmailNotifier is a low-level module that implements the Notifier interface
+type EmailNotifier struct{}
+
+func (e EmailNotifier) Send(message string) error {
+	fmt.Println("Sending email with message:", message)
+	return nil
+}
+
+// SMSNotifier is another low-level module that implements the Notifier interface
+type SMSNotifier struct{}
+
+func (s SMSNotifier) Send(message string) error {
+	fmt.Println("Sending SMS with message:", message)
+	return nil
+}
@@ -0,0 +1,58 @@
+package repository
+
+import "context"
+
+// Reader is segregated from the write-side interfaces so a call site that
+// only ever reads an entity (e.g. a read-only handler) depends on exactly
+// that capability, in the spirit of the ISP example elsewhere in this repo.
+type Reader[T any, ID comparable] interface {
+	Get(ctx context.Context, id ID) (T, error)
+}
+
+// Writer creates new entities.
+type Writer[T any] interface {
+	Create(ctx context.Context, entity T) (T, error)
+}
+
+// Updater replaces an existing entity.
+type Updater[T any, ID comparable] interface {
+	Update(ctx context.Context, id ID, entity T) (T, error)
+}
+
+// Deleter removes an entity by id.
+type Deleter[ID comparable] interface {
+	Delete(ctx context.Context, id ID) error
+}
+
+// ListOptions controls pagination and filtering for Lister.List. Filter maps
+// a column/field name (as used by the backend's Mapper or document tags,
+// e.g. "name", "email") to a value every returned entity must equal; an
+// empty or nil Filter matches everything.
+type ListOptions struct {
+	Limit  int
+	Offset int
+	Filter map[string]any
+}
+
+// Lister returns a page of entities.
+type Lister[T any] interface {
+	List(ctx context.Context, opts ListOptions) ([]T, error)
+}
+
+// CRUD composes the segregated interfaces for callers that genuinely need
+// full access to an entity.
+type CRUD[T any, ID comparable] interface {
+	Reader[T, ID]
+	Writer[T]
+	Updater[T, ID]
+	Deleter[ID]
+	Lister[T]
+}
+
+// ReadOnlyUser demonstrates the ISP benefit at a call site: a handler wired
+// to only read users depends on Reader[User, int], so it cannot accidentally
+// call Create/Update/Delete even though the underlying repository supports
+// them.
+func ReadOnlyUser(ctx context.Context, r Reader[User, int], id int) (User, error) {
+	return r.Get(ctx, id)
+}
@@ -0,0 +1,120 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/Riteek712/SOLID-GO/repository"
+)
+
+// UserRepository is an in-memory implementation of repository.UserRepository.
+// It keeps no external dependencies, so it is the preferred backend for unit
+// tests that exercise UserService without a live database.
+type UserRepository struct {
+	mu     sync.Mutex
+	nextID int
+	users  map[int]repository.User
+}
+
+// NewUserRepository creates an empty in-memory UserRepository.
+func NewUserRepository() *UserRepository {
+	return &UserRepository{
+		nextID: 1,
+		users:  make(map[int]repository.User),
+	}
+}
+
+func (r *UserRepository) Create(ctx context.Context, user repository.User) (repository.User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	user.ID = r.nextID
+	r.nextID++
+	r.users[user.ID] = user
+	return user, nil
+}
+
+func (r *UserRepository) Get(ctx context.Context, id int) (repository.User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	user, ok := r.users[id]
+	if !ok {
+		return repository.User{}, fmt.Errorf("user %d: %w", id, repository.ErrNotFound)
+	}
+	return user, nil
+}
+
+func (r *UserRepository) Update(ctx context.Context, id int, user repository.User) (repository.User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.users[id]; !ok {
+		return repository.User{}, fmt.Errorf("user %d: %w", id, repository.ErrNotFound)
+	}
+	user.ID = id
+	r.users[id] = user
+	return user, nil
+}
+
+func (r *UserRepository) Delete(ctx context.Context, id int) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.users[id]; !ok {
+		return fmt.Errorf("user %d: %w", id, repository.ErrNotFound)
+	}
+	delete(r.users, id)
+	return nil
+}
+
+func (r *UserRepository) List(ctx context.Context, opts repository.ListOptions) ([]repository.User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	ids := make([]int, 0, len(r.users))
+	for id := range r.users {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+
+	filtered := make([]repository.User, 0, len(ids))
+	for _, id := range ids {
+		if user := r.users[id]; matchesFilter(user, opts.Filter) {
+			filtered = append(filtered, user)
+		}
+	}
+
+	start := opts.Offset
+	if start > len(filtered) {
+		start = len(filtered)
+	}
+	end := len(filtered)
+	if opts.Limit > 0 && start+opts.Limit < end {
+		end = start + opts.Limit
+	}
+	return append([]repository.User{}, filtered[start:end]...), nil
+}
+
+// matchesFilter reports whether user satisfies every "name"/"email" equality
+// constraint in filter. Unknown keys and type mismatches simply fail to
+// match rather than erroring, since ListOptions.Filter is untyped.
+func matchesFilter(user repository.User, filter map[string]any) bool {
+	for key, want := range filter {
+		switch key {
+		case "name":
+			if name, ok := want.(string); !ok || user.Name != name {
+				return false
+			}
+		case "email":
+			if email, ok := want.(string); !ok || user.Email != email {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,69 @@
+package memory_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Riteek712/SOLID-GO/repository"
+	"github.com/Riteek712/SOLID-GO/repository/memory"
+)
+
+func seedUsers(t *testing.T, repo *memory.UserRepository, users ...repository.User) {
+	t.Helper()
+	ctx := context.Background()
+	for _, u := range users {
+		if _, err := repo.Create(ctx, u); err != nil {
+			t.Fatalf("Create(%+v): %v", u, err)
+		}
+	}
+}
+
+func TestUserRepositoryListFiltersByField(t *testing.T) {
+	repo := memory.NewUserRepository()
+	seedUsers(t, repo,
+		repository.User{Name: "Ada Lovelace", Email: "ada@example.com"},
+		repository.User{Name: "Grace Hopper", Email: "grace@example.com"},
+		repository.User{Name: "Ada King", Email: "ada.king@example.com"},
+	)
+
+	got, err := repo.List(context.Background(), repository.ListOptions{
+		Filter: map[string]any{"name": "Grace Hopper"},
+	})
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if len(got) != 1 || got[0].Email != "grace@example.com" {
+		t.Fatalf("List with name filter = %+v, want only Grace Hopper", got)
+	}
+}
+
+func TestUserRepositoryListUnknownFilterKeyMatchesNothing(t *testing.T) {
+	repo := memory.NewUserRepository()
+	seedUsers(t, repo, repository.User{Name: "Ada Lovelace", Email: "ada@example.com"})
+
+	got, err := repo.List(context.Background(), repository.ListOptions{
+		Filter: map[string]any{"role": "admin"},
+	})
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if len(got) != 0 {
+		t.Fatalf("List with an unknown filter key = %+v, want none", got)
+	}
+}
+
+func TestUserRepositoryListNoFilterReturnsEverything(t *testing.T) {
+	repo := memory.NewUserRepository()
+	seedUsers(t, repo,
+		repository.User{Name: "Ada Lovelace", Email: "ada@example.com"},
+		repository.User{Name: "Grace Hopper", Email: "grace@example.com"},
+	)
+
+	got, err := repo.List(context.Background(), repository.ListOptions{})
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("List with no filter returned %d users, want 2", len(got))
+	}
+}
@@ -0,0 +1,132 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/Riteek712/SOLID-GO/repository"
+)
+
+// userDocument is the BSON shape stored in the "users" collection. The
+// numeric ID the rest of the system uses is stored as the document's _id in
+// place of a Mongo ObjectID, since UserRepository must still satisfy
+// repository.UserRepository's int id.
+type userDocument struct {
+	ID    int    `bson:"_id"`
+	Name  string `bson:"name"`
+	Email string `bson:"email"`
+}
+
+// counterDocument backs the classic MongoDB auto-increment pattern: a single
+// document per sequence, incremented atomically via FindOneAndUpdate.
+type counterDocument struct {
+	Seq int `bson:"seq"`
+}
+
+// UserRepository is a MongoDB-backed implementation of repository.UserRepository.
+type UserRepository struct {
+	collection *mongo.Collection
+	counters   *mongo.Collection
+}
+
+// NewUserRepository creates a new UserRepository backed by collection, using
+// counters to allocate ids on Create the same way the memory and Postgres
+// backends auto-assign them.
+func NewUserRepository(collection, counters *mongo.Collection) *UserRepository {
+	return &UserRepository{collection: collection, counters: counters}
+}
+
+func (r *UserRepository) nextID(ctx context.Context) (int, error) {
+	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+	var counter counterDocument
+	err := r.counters.FindOneAndUpdate(ctx, bson.M{"_id": "users"}, bson.M{"$inc": bson.M{"seq": 1}}, opts).Decode(&counter)
+	if err != nil {
+		return 0, fmt.Errorf("allocating user id: %w", err)
+	}
+	return counter.Seq, nil
+}
+
+func (r *UserRepository) Create(ctx context.Context, user repository.User) (repository.User, error) {
+	id, err := r.nextID(ctx)
+	if err != nil {
+		return repository.User{}, err
+	}
+	user.ID = id
+
+	doc := userDocument{ID: user.ID, Name: user.Name, Email: user.Email}
+	if _, err := r.collection.InsertOne(ctx, doc); err != nil {
+		return repository.User{}, err
+	}
+	return user, nil
+}
+
+func (r *UserRepository) Get(ctx context.Context, id int) (repository.User, error) {
+	var doc userDocument
+	err := r.collection.FindOne(ctx, bson.M{"_id": id}).Decode(&doc)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return repository.User{}, fmt.Errorf("user %d: %w", id, repository.ErrNotFound)
+	}
+	if err != nil {
+		return repository.User{}, err
+	}
+	return repository.User{ID: doc.ID, Name: doc.Name, Email: doc.Email}, nil
+}
+
+func (r *UserRepository) Update(ctx context.Context, id int, user repository.User) (repository.User, error) {
+	update := bson.M{"$set": bson.M{"name": user.Name, "email": user.Email}}
+	result, err := r.collection.UpdateOne(ctx, bson.M{"_id": id}, update)
+	if err != nil {
+		return repository.User{}, err
+	}
+	if result.MatchedCount == 0 {
+		return repository.User{}, fmt.Errorf("user %d: %w", id, repository.ErrNotFound)
+	}
+	user.ID = id
+	return user, nil
+}
+
+func (r *UserRepository) Delete(ctx context.Context, id int) error {
+	result, err := r.collection.DeleteOne(ctx, bson.M{"_id": id})
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return fmt.Errorf("user %d: %w", id, repository.ErrNotFound)
+	}
+	return nil
+}
+
+func (r *UserRepository) List(ctx context.Context, opts repository.ListOptions) ([]repository.User, error) {
+	findOpts := options.Find().SetSkip(int64(opts.Offset))
+	if opts.Limit > 0 {
+		findOpts.SetLimit(int64(opts.Limit))
+	}
+
+	// opts.Filter keys already name document fields ("name", "email"), so it
+	// doubles directly as a bson.M equality filter.
+	filter := bson.M{}
+	for key, want := range opts.Filter {
+		filter[key] = want
+	}
+
+	cursor, err := r.collection.Find(ctx, filter, findOpts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var users []repository.User
+	for cursor.Next(ctx) {
+		var doc userDocument
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, err
+		}
+		users = append(users, repository.User{ID: doc.ID, Name: doc.Name, Email: doc.Email})
+	}
+	return users, cursor.Err()
+}
@@ -0,0 +1,34 @@
+package postgres
+
+import (
+	"database/sql"
+
+	_ "github.com/lib/pq"
+
+	"github.com/Riteek712/SOLID-GO/repository"
+)
+
+// userMapper maps repository.User onto the users table's (name, email)
+// columns, letting NewUserRepository reuse repository.SQLRepository instead
+// of hand-writing Scan/QueryRowContext calls.
+type userMapper struct{}
+
+func (userMapper) Columns() []string {
+	return []string{"name", "email"}
+}
+
+func (userMapper) Args(u repository.User) []any {
+	return []any{u.Name, u.Email}
+}
+
+func (userMapper) Scan(row repository.Scanner) (repository.User, error) {
+	var u repository.User
+	err := row.Scan(&u.ID, &u.Name, &u.Email)
+	return u, err
+}
+
+// NewUserRepository creates a Postgres-backed repository.UserRepository for
+// the users table.
+func NewUserRepository(db *sql.DB) *repository.SQLRepository[repository.User, int] {
+	return repository.NewSQLRepository[repository.User, int](db, "users", "id", userMapper{})
+}
@@ -0,0 +1,23 @@
+package repository
+
+import "errors"
+
+// ErrNotFound is the not-found sentinel every UserRepository backend wraps
+// and returns from Get/Update/Delete for an id that doesn't exist, so
+// callers can use errors.Is regardless of which backend is wired in.
+var ErrNotFound = errors.New("repository: user not found")
+
+// User represents the user model shared by all storage backends.
+type User struct {
+	ID    int    `json:"id"`
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+// UserRepository is the abstraction that UserService depends on. High-level
+// business logic is written against this interface so that any storage
+// backend (Postgres, Mongo, an in-memory map for tests, ...) can be plugged
+// in without touching the service layer. It is the composed CRUD for User;
+// call sites that only need one capability should depend on the matching
+// segregated interface instead (e.g. Reader[User, int] for read-only access).
+type UserRepository = CRUD[User, int]
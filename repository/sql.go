@@ -0,0 +1,173 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Scanner is satisfied by both *sql.Row and *sql.Rows, letting Mapper.Scan
+// read either a single-row query result or one row of a multi-row result.
+type Scanner interface {
+	Scan(dest ...any) error
+}
+
+// DBTX is the subset of *sql.DB that SQLRepository needs. *sql.Tx satisfies
+// it too, which is what lets WithTx route a repository through a
+// caller-managed transaction.
+type DBTX interface {
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}
+
+// Mapper translates between a struct T and a database row, so SQLRepository
+// callers don't rewrite Scan/QueryRowContext for every entity.
+type Mapper[T any] interface {
+	// Columns lists every column except the id column, in the order Args
+	// and Scan use them.
+	Columns() []string
+	// Args returns entity's column values in Columns order, for INSERT and
+	// UPDATE statements.
+	Args(entity T) []any
+	// Scan reads one row — ordered id column first, then Columns()... —
+	// into a new T.
+	Scan(row Scanner) (T, error)
+}
+
+// SQLRepository is a database/sql-backed CRUD[T, ID] built on a Mapper[T], so
+// entities only need a Mapper implementation instead of a full repository.
+type SQLRepository[T any, ID comparable] struct {
+	db       DBTX
+	table    string
+	idColumn string
+	mapper   Mapper[T]
+}
+
+// NewSQLRepository creates a SQLRepository for table, keyed by idColumn, that
+// uses mapper to convert between T and rows of the other columns.
+func NewSQLRepository[T any, ID comparable](db DBTX, table, idColumn string, mapper Mapper[T]) *SQLRepository[T, ID] {
+	return &SQLRepository[T, ID]{db: db, table: table, idColumn: idColumn, mapper: mapper}
+}
+
+// WithTx returns a copy of this repository whose queries run against tx
+// instead of the original *sql.DB, so it can be passed to TxRunner.Run and
+// composed with other repository calls in one transaction.
+func (r *SQLRepository[T, ID]) WithTx(tx *sql.Tx) *SQLRepository[T, ID] {
+	return &SQLRepository[T, ID]{db: tx, table: r.table, idColumn: r.idColumn, mapper: r.mapper}
+}
+
+func (r *SQLRepository[T, ID]) Create(ctx context.Context, entity T) (T, error) {
+	cols := r.mapper.Columns()
+	placeholders := make([]string, len(cols))
+	for i := range cols {
+		placeholders[i] = fmt.Sprintf("$%d", i+1)
+	}
+	query := fmt.Sprintf(
+		"INSERT INTO %s (%s) VALUES (%s) RETURNING %s, %s",
+		r.table, strings.Join(cols, ", "), strings.Join(placeholders, ", "),
+		r.idColumn, strings.Join(cols, ", "),
+	)
+	row := r.db.QueryRowContext(ctx, query, r.mapper.Args(entity)...)
+	return r.mapper.Scan(row)
+}
+
+func (r *SQLRepository[T, ID]) Get(ctx context.Context, id ID) (T, error) {
+	cols := r.mapper.Columns()
+	query := fmt.Sprintf(
+		"SELECT %s, %s FROM %s WHERE %s=$1",
+		r.idColumn, strings.Join(cols, ", "), r.table, r.idColumn,
+	)
+	row := r.db.QueryRowContext(ctx, query, id)
+	entity, err := r.mapper.Scan(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return entity, fmt.Errorf("%s %v: %w", r.table, id, ErrNotFound)
+	}
+	return entity, err
+}
+
+func (r *SQLRepository[T, ID]) Update(ctx context.Context, id ID, entity T) (T, error) {
+	cols := r.mapper.Columns()
+	sets := make([]string, len(cols))
+	for i, col := range cols {
+		sets[i] = fmt.Sprintf("%s=$%d", col, i+1)
+	}
+	query := fmt.Sprintf(
+		"UPDATE %s SET %s WHERE %s=$%d RETURNING %s, %s",
+		r.table, strings.Join(sets, ", "), r.idColumn, len(cols)+1,
+		r.idColumn, strings.Join(cols, ", "),
+	)
+	args := append(r.mapper.Args(entity), id)
+	row := r.db.QueryRowContext(ctx, query, args...)
+	entity, err := r.mapper.Scan(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return entity, fmt.Errorf("%s %v: %w", r.table, id, ErrNotFound)
+	}
+	return entity, err
+}
+
+func (r *SQLRepository[T, ID]) Delete(ctx context.Context, id ID) error {
+	query := fmt.Sprintf("DELETE FROM %s WHERE %s=$1", r.table, r.idColumn)
+	result, err := r.db.ExecContext(ctx, query, id)
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return fmt.Errorf("%s %v: %w", r.table, id, ErrNotFound)
+	}
+	return nil
+}
+
+func (r *SQLRepository[T, ID]) List(ctx context.Context, opts ListOptions) ([]T, error) {
+	cols := r.mapper.Columns()
+	query := fmt.Sprintf(
+		"SELECT %s, %s FROM %s",
+		r.idColumn, strings.Join(cols, ", "), r.table,
+	)
+
+	var args []any
+	if len(opts.Filter) > 0 {
+		keys := make([]string, 0, len(opts.Filter))
+		for k := range opts.Filter {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		conditions := make([]string, len(keys))
+		for i, k := range keys {
+			args = append(args, opts.Filter[k])
+			conditions[i] = fmt.Sprintf("%s=$%d", k, len(args))
+		}
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	if opts.Limit > 0 {
+		args = append(args, opts.Limit)
+		query += fmt.Sprintf(" LIMIT $%d", len(args))
+	}
+	args = append(args, opts.Offset)
+	query += fmt.Sprintf(" OFFSET $%d", len(args))
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var entities []T
+	for rows.Next() {
+		entity, err := r.mapper.Scan(rows)
+		if err != nil {
+			return nil, err
+		}
+		entities = append(entities, entity)
+	}
+	return entities, rows.Err()
+}
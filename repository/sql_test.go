@@ -0,0 +1,178 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/Riteek712/SOLID-GO/repository"
+)
+
+// item is the entity the TestSQLRepository* tests exercise SQLRepository
+// against.
+type item struct {
+	ID   int
+	Name string
+}
+
+type itemMapper struct{}
+
+func (itemMapper) Columns() []string { return []string{"name"} }
+func (itemMapper) Args(i item) []any { return []any{i.Name} }
+func (itemMapper) Scan(row repository.Scanner) (item, error) {
+	var i item
+	err := row.Scan(&i.ID, &i.Name)
+	return i, err
+}
+
+// fakeConn is a minimal database/sql/driver.Conn that answers every query or
+// exec through the handlers installed for the test, so SQLRepository can be
+// exercised without a real database.
+type fakeConn struct {
+	query func(query string, args []driver.NamedValue) (driver.Rows, error)
+	exec  func(query string, args []driver.NamedValue) (driver.Result, error)
+}
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return nil, errors.New("fakeConn: Prepare not supported, use QueryContext/ExecContext")
+}
+func (c *fakeConn) Close() error { return nil }
+func (c *fakeConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("fakeConn: Begin not supported")
+}
+
+func (c *fakeConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return c.query(query, args)
+}
+
+func (c *fakeConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return c.exec(query, args)
+}
+
+// fakeDriver opens the single fakeConn configured for the test.
+type fakeDriver struct{ conn *fakeConn }
+
+func (d *fakeDriver) Open(name string) (driver.Conn, error) { return d.conn, nil }
+
+var fakeDriverSeq int64
+
+// openFakeDB registers a uniquely-named driver backed by conn and opens it,
+// since database/sql.Register panics if the same name is registered twice.
+func openFakeDB(t *testing.T, conn *fakeConn) *sql.DB {
+	t.Helper()
+	name := fmt.Sprintf("fakedriver-%d", atomic.AddInt64(&fakeDriverSeq, 1))
+	sql.Register(name, &fakeDriver{conn: conn})
+	db, err := sql.Open(name, "")
+	if err != nil {
+		t.Fatalf("sql.Open: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+type fakeRows struct {
+	cols []string
+	data [][]driver.Value
+	pos  int
+}
+
+func (r *fakeRows) Columns() []string { return r.cols }
+func (r *fakeRows) Close() error      { return nil }
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.pos >= len(r.data) {
+		return io.EOF
+	}
+	copy(dest, r.data[r.pos])
+	r.pos++
+	return nil
+}
+
+type fakeResult struct{ affected int64 }
+
+func (r fakeResult) LastInsertId() (int64, error) { return 0, nil }
+func (r fakeResult) RowsAffected() (int64, error) { return r.affected, nil }
+
+func TestSQLRepositoryListOmitsLimitWhenZero(t *testing.T) {
+	var gotQuery string
+	conn := &fakeConn{
+		query: func(query string, args []driver.NamedValue) (driver.Rows, error) {
+			gotQuery = query
+			return &fakeRows{cols: []string{"id", "name"}}, nil
+		},
+	}
+	db := openFakeDB(t, conn)
+	repo := repository.NewSQLRepository[item, int](db, "items", "id", itemMapper{})
+
+	if _, err := repo.List(context.Background(), repository.ListOptions{}); err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if strings.Contains(gotQuery, "LIMIT") {
+		t.Fatalf("List with Limit=0 issued a LIMIT clause: %q", gotQuery)
+	}
+	if !strings.Contains(gotQuery, "OFFSET") {
+		t.Fatalf("List query missing OFFSET clause: %q", gotQuery)
+	}
+}
+
+func TestSQLRepositoryListAppliesLimitAndFilter(t *testing.T) {
+	var gotQuery string
+	var gotArgs []driver.NamedValue
+	conn := &fakeConn{
+		query: func(query string, args []driver.NamedValue) (driver.Rows, error) {
+			gotQuery, gotArgs = query, args
+			return &fakeRows{cols: []string{"id", "name"}}, nil
+		},
+	}
+	db := openFakeDB(t, conn)
+	repo := repository.NewSQLRepository[item, int](db, "items", "id", itemMapper{})
+
+	opts := repository.ListOptions{Limit: 10, Offset: 5, Filter: map[string]any{"name": "ada"}}
+	if _, err := repo.List(context.Background(), opts); err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if !strings.Contains(gotQuery, "WHERE name=$1") {
+		t.Fatalf("List query missing WHERE clause: %q", gotQuery)
+	}
+	if !strings.Contains(gotQuery, "LIMIT $2 OFFSET $3") {
+		t.Fatalf("List query missing LIMIT/OFFSET clauses: %q", gotQuery)
+	}
+	if len(gotArgs) != 3 {
+		t.Fatalf("List passed %d args, want 3", len(gotArgs))
+	}
+}
+
+func TestSQLRepositoryGetMapsNoRowsToErrNotFound(t *testing.T) {
+	conn := &fakeConn{
+		query: func(query string, args []driver.NamedValue) (driver.Rows, error) {
+			return &fakeRows{cols: []string{"id", "name"}}, nil
+		},
+	}
+	db := openFakeDB(t, conn)
+	repo := repository.NewSQLRepository[item, int](db, "items", "id", itemMapper{})
+
+	_, err := repo.Get(context.Background(), 404)
+	if !errors.Is(err, repository.ErrNotFound) {
+		t.Fatalf("Get on a missing row: got %v, want ErrNotFound", err)
+	}
+}
+
+func TestSQLRepositoryDeleteMissingRowReturnsErrNotFound(t *testing.T) {
+	conn := &fakeConn{
+		exec: func(query string, args []driver.NamedValue) (driver.Result, error) {
+			return fakeResult{affected: 0}, nil
+		},
+	}
+	db := openFakeDB(t, conn)
+	repo := repository.NewSQLRepository[item, int](db, "items", "id", itemMapper{})
+
+	err := repo.Delete(context.Background(), 404)
+	if !errors.Is(err, repository.ErrNotFound) {
+		t.Fatalf("Delete on a missing row: got %v, want ErrNotFound", err)
+	}
+}
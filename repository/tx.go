@@ -0,0 +1,40 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+)
+
+// TxRunner lets callers compose multiple repository calls into a single
+// sql.Tx, committing only if every call in fn succeeds.
+type TxRunner struct {
+	db *sql.DB
+}
+
+// NewTxRunner creates a TxRunner backed by db.
+func NewTxRunner(db *sql.DB) *TxRunner {
+	return &TxRunner{db: db}
+}
+
+// Run executes fn inside a transaction, committing on success and rolling
+// back if fn returns an error, or if fn panics — the panic is re-thrown
+// after the rollback so callers still see it. An error from the commit
+// itself is returned as is, with no further rollback call.
+func (t *TxRunner) Run(ctx context.Context, fn func(ctx context.Context, tx *sql.Tx) error) error {
+	tx, err := t.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		}
+	}()
+
+	if err := fn(ctx, tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
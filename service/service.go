@@ -0,0 +1,45 @@
+package service
+
+import (
+	"context"
+
+	"github.com/Riteek712/SOLID-GO/repository"
+)
+
+// User is the model the CRUD example's layers operate on.
+type User = repository.User
+
+// UserService is the business-logic layer for the User CRUD example. The
+// endpoint layer is written against this interface, not against the
+// repository, so transports never reach past it into storage concerns.
+type UserService interface {
+	CreateUser(ctx context.Context, name, email string) (User, error)
+	GetUser(ctx context.Context, id int) (User, error)
+	UpdateUser(ctx context.Context, id int, name, email string) (User, error)
+	DeleteUser(ctx context.Context, id int) error
+}
+
+type userService struct {
+	repo repository.UserRepository
+}
+
+// NewUserService creates a UserService backed by repo.
+func NewUserService(repo repository.UserRepository) UserService {
+	return &userService{repo: repo}
+}
+
+func (s *userService) CreateUser(ctx context.Context, name, email string) (User, error) {
+	return s.repo.Create(ctx, User{Name: name, Email: email})
+}
+
+func (s *userService) GetUser(ctx context.Context, id int) (User, error) {
+	return s.repo.Get(ctx, id)
+}
+
+func (s *userService) UpdateUser(ctx context.Context, id int, name, email string) (User, error) {
+	return s.repo.Update(ctx, id, User{Name: name, Email: email})
+}
+
+func (s *userService) DeleteUser(ctx context.Context, id int) error {
+	return s.repo.Delete(ctx, id)
+}
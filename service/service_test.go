@@ -0,0 +1,56 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Riteek712/SOLID-GO/repository"
+	"github.com/Riteek712/SOLID-GO/repository/memory"
+	"github.com/Riteek712/SOLID-GO/service"
+)
+
+func TestUserServiceCRUDAgainstMemoryRepository(t *testing.T) {
+	ctx := context.Background()
+	svc := service.NewUserService(memory.NewUserRepository())
+
+	created, err := svc.CreateUser(ctx, "Ada Lovelace", "ada@example.com")
+	if err != nil {
+		t.Fatalf("CreateUser: %v", err)
+	}
+	if created.ID == 0 {
+		t.Fatal("CreateUser did not assign an id")
+	}
+
+	got, err := svc.GetUser(ctx, created.ID)
+	if err != nil {
+		t.Fatalf("GetUser: %v", err)
+	}
+	if got != created {
+		t.Fatalf("GetUser = %+v, want %+v", got, created)
+	}
+
+	updated, err := svc.UpdateUser(ctx, created.ID, "Ada King", "ada.king@example.com")
+	if err != nil {
+		t.Fatalf("UpdateUser: %v", err)
+	}
+	if updated.Name != "Ada King" || updated.Email != "ada.king@example.com" {
+		t.Fatalf("UpdateUser returned %+v, want updated name/email", updated)
+	}
+
+	if err := svc.DeleteUser(ctx, created.ID); err != nil {
+		t.Fatalf("DeleteUser: %v", err)
+	}
+
+	if _, err := svc.GetUser(ctx, created.ID); !errors.Is(err, repository.ErrNotFound) {
+		t.Fatalf("GetUser after delete: got %v, want ErrNotFound", err)
+	}
+}
+
+func TestUserServiceGetMissingUserReturnsErrNotFound(t *testing.T) {
+	svc := service.NewUserService(memory.NewUserRepository())
+
+	if _, err := svc.GetUser(context.Background(), 404); !errors.Is(err, repository.ErrNotFound) {
+		t.Fatalf("GetUser: got %v, want ErrNotFound", err)
+	}
+}
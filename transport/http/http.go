@@ -0,0 +1,182 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/Riteek712/SOLID-GO/endpoint"
+)
+
+// DecodeRequestFunc decodes an incoming HTTP request into the request value
+// an Endpoint expects.
+type DecodeRequestFunc func(ctx context.Context, r *http.Request) (request any, err error)
+
+// EncodeResponseFunc writes an Endpoint's response to the HTTP response.
+type EncodeResponseFunc func(ctx context.Context, w http.ResponseWriter, response any) error
+
+// ErrorEncoder writes an error returned by the Endpoint, or by encoding its
+// response, to the HTTP response. Routes customize it to map a domain error
+// to the right status code (e.g. "not found" vs. "internal"). Decoding
+// errors do not reach it: Handler always answers those with a 400.
+type ErrorEncoder func(ctx context.Context, err error, w http.ResponseWriter)
+
+// Handler adapts a single go-kit style Endpoint to net/http.
+type Handler struct {
+	endpoint     endpoint.Endpoint
+	decode       DecodeRequestFunc
+	encode       EncodeResponseFunc
+	errorEncoder ErrorEncoder
+}
+
+// Option configures a Handler built by NewHandler.
+type Option func(*Handler)
+
+// WithErrorEncoder overrides how a Handler encodes errors from the endpoint
+// or from encoding its response. The default responds with 500 and the error
+// text.
+func WithErrorEncoder(ee ErrorEncoder) Option {
+	return func(h *Handler) { h.errorEncoder = ee }
+}
+
+// NewHandler creates an http.Handler wrapping e, translating requests and
+// responses through decode and encode.
+func NewHandler(e endpoint.Endpoint, decode DecodeRequestFunc, encode EncodeResponseFunc, opts ...Option) *Handler {
+	h := &Handler{
+		endpoint: e,
+		decode:   decode,
+		encode:   encode,
+		errorEncoder: func(ctx context.Context, err error, w http.ResponseWriter) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	request, err := h.decode(ctx, r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	response, err := h.endpoint(ctx, request)
+	if err != nil {
+		h.errorEncoder(ctx, err, w)
+		return
+	}
+
+	if err := h.encode(ctx, w, response); err != nil {
+		h.errorEncoder(ctx, err, w)
+	}
+}
+
+// userBody is the JSON shape accepted by create/update requests.
+type userBody struct {
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+// DecodeCreateUserRequest reads a userBody from the request body into an
+// endpoint.CreateUserRequest.
+func DecodeCreateUserRequest(ctx context.Context, r *http.Request) (any, error) {
+	var body userBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return nil, fmt.Errorf("invalid input: %w", err)
+	}
+	return endpoint.CreateUserRequest{Name: body.Name, Email: body.Email}, nil
+}
+
+// DecodeUserIDRequest reads the "id" query parameter into an
+// endpoint.UserIDRequest, for routes that only need a user id.
+func DecodeUserIDRequest(ctx context.Context, r *http.Request) (any, error) {
+	id, err := strconv.Atoi(r.URL.Query().Get("id"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid id: %w", err)
+	}
+	return endpoint.UserIDRequest{ID: id}, nil
+}
+
+// DecodeUpdateUserRequest reads the "id" query parameter and a userBody into
+// an endpoint.UpdateUserRequest.
+func DecodeUpdateUserRequest(ctx context.Context, r *http.Request) (any, error) {
+	id, err := strconv.Atoi(r.URL.Query().Get("id"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid id: %w", err)
+	}
+	var body userBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return nil, fmt.Errorf("invalid input: %w", err)
+	}
+	return endpoint.UpdateUserRequest{ID: id, Name: body.Name, Email: body.Email}, nil
+}
+
+// EncodeUserResponse writes an endpoint.UserResponse as a 200 JSON body. The
+// status is overridden to 201 for the create route via EncodeCreatedResponse.
+func EncodeUserResponse(ctx context.Context, w http.ResponseWriter, response any) error {
+	return json.NewEncoder(w).Encode(response.(endpoint.UserResponse).User)
+}
+
+// EncodeCreatedResponse writes an endpoint.UserResponse as a 201 JSON body.
+func EncodeCreatedResponse(ctx context.Context, w http.ResponseWriter, response any) error {
+	w.WriteHeader(http.StatusCreated)
+	return json.NewEncoder(w).Encode(response.(endpoint.UserResponse).User)
+}
+
+// EncodeNoContentResponse writes a 204 with no body, for DeleteUser.
+func EncodeNoContentResponse(ctx context.Context, w http.ResponseWriter, response any) error {
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+// NotFoundErrorEncoder maps any endpoint error to a 404, matching the
+// behavior the original handler-based implementation used for
+// Get/Update/Delete.
+func NotFoundErrorEncoder(ctx context.Context, err error, w http.ResponseWriter) {
+	http.Error(w, "User not found", http.StatusNotFound)
+}
+
+// InternalErrorEncoder maps any endpoint error to a 500 with msg, matching
+// the original handler's behavior for CreateUser failures.
+func InternalErrorEncoder(msg string) ErrorEncoder {
+	return func(ctx context.Context, err error, w http.ResponseWriter) {
+		http.Error(w, msg, http.StatusInternalServerError)
+	}
+}
+
+// Endpoints bundles the CRUD example's endpoints so they can be registered
+// onto a mux in one call.
+type Endpoints struct {
+	CreateUser endpoint.Endpoint
+	GetUser    endpoint.Endpoint
+	UpdateUser endpoint.Endpoint
+	DeleteUser endpoint.Endpoint
+}
+
+// RegisterHandlers mounts endpoints on mux using the same routes the
+// previous handler-based implementation exposed.
+func RegisterHandlers(mux *http.ServeMux, endpoints Endpoints) {
+	mux.Handle("/create", NewHandler(
+		endpoints.CreateUser, DecodeCreateUserRequest, EncodeCreatedResponse,
+		WithErrorEncoder(InternalErrorEncoder("Failed to create user")),
+	))
+	mux.Handle("/get", NewHandler(
+		endpoints.GetUser, DecodeUserIDRequest, EncodeUserResponse,
+		WithErrorEncoder(NotFoundErrorEncoder),
+	))
+	mux.Handle("/update", NewHandler(
+		endpoints.UpdateUser, DecodeUpdateUserRequest, EncodeUserResponse,
+		WithErrorEncoder(NotFoundErrorEncoder),
+	))
+	mux.Handle("/delete", NewHandler(
+		endpoints.DeleteUser, DecodeUserIDRequest, EncodeNoContentResponse,
+		WithErrorEncoder(NotFoundErrorEncoder),
+	))
+}